@@ -0,0 +1,200 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/nullbio/null.v4/convert"
+)
+
+// byteSource records which driver value kind NullByte.Scan last saw, so
+// Value can echo back the same representation rather than always
+// coercing to int64 -- a text-backed CHAR(1) column round-trips to
+// string, a []byte-backed one round-trips to []byte.
+type byteSource int
+
+const (
+	byteSourceInt byteSource = iota
+	byteSourceBytes
+	byteSourceString
+)
+
+// NullByte is a nullable byte.
+type NullByte struct {
+	Byte  byte
+	Valid bool
+
+	src byteSource
+}
+
+// Byte is a nullable byte.
+// JSON marshals to null if null.
+// Considered null to SQL only if not Valid; a valid zero byte is not null.
+type Byte struct {
+	NullByte
+}
+
+// NewByte creates a new Byte
+func NewByte(b byte, valid bool) Byte {
+	return Byte{
+		NullByte: NullByte{
+			Byte:  b,
+			Valid: valid,
+		},
+	}
+}
+
+// ByteFrom creates a new Byte that will always be valid.
+func ByteFrom(b byte) Byte {
+	return NewByte(b, true)
+}
+
+// ByteFromPtr creates a new Byte that be null if b is nil.
+func ByteFromPtr(b *byte) Byte {
+	if b == nil {
+		return NewByte(0, false)
+	}
+	return NewByte(*b, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It will unmarshal to a null Byte if the input is a blank or null.
+// It will return an error if the input is not a one-character JSON string
+// whose character is in the range U+0000 to U+00FF.
+func (b *Byte) UnmarshalJSON(data []byte) error {
+	if data == nil || len(data) == 0 || bytes.Equal(data, nullLiteral) {
+		b.Byte, b.Valid = 0, false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON string: %w", err)
+	}
+	r := []rune(s)
+	if len(r) != 1 || r[0] > 0xFF {
+		return fmt.Errorf("null: JSON input %q is not a one-character string", data)
+	}
+
+	b.Byte = byte(r[0])
+	b.Valid = true
+
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Byte if the input is blank.
+// It will return an error if the input is not a single byte or blank.
+func (b *Byte) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		b.Byte, b.Valid = 0, false
+		return nil
+	}
+
+	if len(text) != 1 {
+		return fmt.Errorf("null: text input %q is not a single byte", text)
+	}
+
+	b.Byte = text[0]
+	b.Valid = true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Byte is invalid.
+func (b Byte) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return nullLiteral, nil
+	}
+	return json.Marshal(string(b.Byte))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Byte is invalid.
+func (b Byte) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return []byte{b.Byte}, nil
+}
+
+// SetValid changes this Byte's value and also sets it to be non-null.
+func (b *Byte) SetValid(n byte) {
+	b.Byte = n
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this Byte's value, or a nil pointer if this Byte is null.
+func (b Byte) Ptr() *byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Byte
+}
+
+// IsZero returns true for a null Byte, for future omitempty support (Go 1.4?)
+func (b Byte) IsZero() bool {
+	return !b.Valid
+}
+
+// Scan implements the Scanner interface. It accepts []byte, string, and
+// numeric integer values, coercing single-character results into Byte.
+func (n *NullByte) Scan(value interface{}) error {
+	if value == nil {
+		n.Byte, n.Valid = 0, false
+		return nil
+	}
+	n.Valid = true
+
+	switch v := value.(type) {
+	case int64:
+		n.Byte = byte(v)
+		n.src = byteSourceInt
+		return nil
+	case int:
+		n.Byte = byte(v)
+		n.src = byteSourceInt
+		return nil
+	case string:
+		if len(v) != 1 {
+			return fmt.Errorf("null: scanned value %q does not convert to a single byte", v)
+		}
+		n.Byte = v[0]
+		n.src = byteSourceString
+		return nil
+	}
+
+	var buf []byte
+	if err := convert.ConvertAssign(&buf, value); err != nil {
+		return err
+	}
+	if len(buf) != 1 {
+		return fmt.Errorf("null: scanned value %q does not convert to a single byte", buf)
+	}
+	n.Byte = buf[0]
+	n.src = byteSourceBytes
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. It echoes back the same
+// representation Scan last saw (int64, string, or []byte); a Byte that
+// was never scanned defaults to int64, matching NewByte/ByteFrom usage
+// against numeric columns.
+func (n NullByte) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	switch n.src {
+	case byteSourceString:
+		return string(n.Byte), nil
+	case byteSourceBytes:
+		return []byte{n.Byte}, nil
+	default:
+		return int64(n.Byte), nil
+	}
+}
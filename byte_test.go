@@ -0,0 +1,126 @@
+package null
+
+import "testing"
+
+func TestByteConstructors(t *testing.T) {
+	if b := NewByte('y', true); !b.Valid || b.Byte != 'y' {
+		t.Errorf("NewByte: got %+v", b)
+	}
+	if b := ByteFrom('y'); !b.Valid {
+		t.Errorf("ByteFrom should always be valid")
+	}
+	if b := ByteFromPtr(nil); b.Valid {
+		t.Errorf("ByteFromPtr(nil) should be invalid")
+	}
+	c := byte('n')
+	if b := ByteFromPtr(&c); !b.Valid || b.Byte != 'n' {
+		t.Errorf("ByteFromPtr should carry the pointed-to byte, got %+v", b)
+	}
+}
+
+func TestByteMarshalJSONRoundTrip(t *testing.T) {
+	orig := ByteFrom('"')
+
+	data, err := orig.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"\""` {
+		t.Errorf("a quote byte must be escaped, got %s", data)
+	}
+
+	var got Byte
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Valid || got.Byte != '"' {
+		t.Errorf("round trip: got %+v, want %+v", got, orig)
+	}
+}
+
+func TestByteUnmarshalJSONRejectsMultiCharacter(t *testing.T) {
+	var b Byte
+	if err := b.UnmarshalJSON([]byte(`"ab"`)); err == nil {
+		t.Errorf("a multi-character JSON string should be rejected")
+	}
+}
+
+func TestByteScanInt(t *testing.T) {
+	var n NullByte
+	if err := n.Scan(int64('Y')); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.Byte != 'Y' {
+		t.Errorf("Scan(int64): got %+v", n)
+	}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != int64('Y') {
+		t.Errorf("Value should echo int64 for a numeric source, got %v (%T)", v, v)
+	}
+}
+
+func TestByteScanString(t *testing.T) {
+	var n NullByte
+	if err := n.Scan("Y"); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.Byte != 'Y' {
+		t.Errorf("Scan(string): got %+v", n)
+	}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "Y" {
+		t.Errorf("Value should echo string for a text source, got %v (%T)", v, v)
+	}
+}
+
+func TestByteScanStringRejectsMultiCharacter(t *testing.T) {
+	var n NullByte
+	if err := n.Scan("YES"); err == nil {
+		t.Errorf("a multi-character string should be rejected")
+	}
+}
+
+func TestByteScanBytes(t *testing.T) {
+	var n NullByte
+	if err := n.Scan([]byte("N")); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.Byte != 'N' {
+		t.Errorf("Scan([]byte): got %+v", n)
+	}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, ok := v.([]byte)
+	if !ok || len(got) != 1 || got[0] != 'N' {
+		t.Errorf("Value should echo []byte for a []byte source, got %v (%T)", v, v)
+	}
+}
+
+func TestByteScanNil(t *testing.T) {
+	n := NullByte{Byte: 'x', Valid: true}
+	if err := n.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if n.Valid {
+		t.Errorf("Scan(nil) should be invalid, got %+v", n)
+	}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value of a null Byte should be nil, got %v", v)
+	}
+}
@@ -1,11 +1,34 @@
 package null
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 
 	"gopkg.in/nullbio/null.v4/convert"
 )
 
+// BytesFormat governs how Bytes is encoded to and decoded from JSON.
+type BytesFormat int
+
+// The BytesFormat values that BytesJSONFormat and Bytes.Format accept.
+// BytesFormatDefault defers to the package-level BytesJSONFormat.
+const (
+	BytesFormatDefault BytesFormat = iota
+	BytesFormatBase64
+	BytesFormatRaw
+	BytesFormatHex
+)
+
+// BytesJSONFormat controls the default JSON wire format for every Bytes
+// value whose own Format is BytesFormatDefault. It defaults to
+// BytesFormatBase64 to match encoding/json's treatment of []byte. Set it
+// to BytesFormatRaw to restore this package's pre-1.x behavior of
+// passing the payload through unmodified.
+var BytesJSONFormat = BytesFormatBase64
+
 // NullBytes is a nullable byte slice.
 type NullBytes struct {
 	Bytes []byte
@@ -17,6 +40,9 @@ type NullBytes struct {
 // Considered null to SQL if zero.
 type Bytes struct {
 	NullBytes
+	// Format overrides BytesJSONFormat for this value. Leave it as
+	// BytesFormatDefault to use the package-level setting.
+	Format BytesFormat
 }
 
 // NewBytes creates a new Bytes
@@ -44,27 +70,46 @@ func BytesFromPtr(b *[]byte) Bytes {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// Bytes UnmarshalJSON is different in that it only
-// unmarshals sql.NullBytes defined as JSON objects,
-// It supports all JSON types.
-// It also supports unmarshalling a sql.NullBytes.
+// The JSON literal null and an empty input both unmarshal to a null
+// Bytes. Otherwise the payload is decoded according to b.Format (or
+// BytesJSONFormat if b.Format is BytesFormatDefault): base64 and hex
+// expect a JSON string, raw accepts any JSON value verbatim.
 func (b *Bytes) UnmarshalJSON(data []byte) error {
-	if data == nil || len(data) == 0 {
+	if data == nil || len(data) == 0 || bytes.Equal(data, nullLiteral) {
 		b.Bytes = nil
 		b.Valid = false
-	} else {
+		return nil
+	}
+
+	switch b.resolveFormat() {
+	case BytesFormatRaw:
 		b.Bytes = append(b.Bytes[0:0], data...)
-		b.Valid = true
+	case BytesFormatHex:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		decoded, err := hex.DecodeString(s)
+		if err != nil {
+			return fmt.Errorf("null: couldn't unmarshal hex JSON: %w", err)
+		}
+		b.Bytes = decoded
+	default:
+		if err := json.Unmarshal(data, &b.Bytes); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal base64 JSON: %w", err)
+		}
 	}
 
+	b.Valid = true
+
 	return nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null Bytes if the input is blank.
-// It will return an error if the input is not an integer, blank, or "null".
 func (b *Bytes) UnmarshalText(text []byte) error {
 	if text == nil || len(text) == 0 {
+		b.Bytes = nil
 		b.Valid = false
 	} else {
 		b.Bytes = append(b.Bytes[0:0], text...)
@@ -75,12 +120,35 @@ func (b *Bytes) UnmarshalText(text []byte) error {
 }
 
 // MarshalJSON implements json.Marshaler.
-// It will encode null if the Bytes is invalid.
+// It will encode null if the Bytes is invalid. Otherwise the payload is
+// encoded according to b.Format (or BytesJSONFormat if b.Format is
+// BytesFormatDefault).
 func (b Bytes) MarshalJSON() ([]byte, error) {
 	if !b.Valid {
-		return []byte("null"), nil
+		return nullLiteral, nil
 	}
-	return b.Bytes, nil
+
+	switch b.resolveFormat() {
+	case BytesFormatRaw:
+		return b.Bytes, nil
+	case BytesFormatHex:
+		dst := make([]byte, hex.EncodedLen(len(b.Bytes))+2)
+		dst[0] = '"'
+		hex.Encode(dst[1:len(dst)-1], b.Bytes)
+		dst[len(dst)-1] = '"'
+		return dst, nil
+	default:
+		return json.Marshal(b.Bytes)
+	}
+}
+
+// resolveFormat returns the effective BytesFormat for this value,
+// falling back to BytesJSONFormat when Format is BytesFormatDefault.
+func (b Bytes) resolveFormat() BytesFormat {
+	if b.Format != BytesFormatDefault {
+		return b.Format
+	}
+	return BytesJSONFormat
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -0,0 +1,140 @@
+package null
+
+import (
+	"bytes"
+	"testing"
+	"testing/quick"
+)
+
+func TestBytesMarshalJSONBase64RoundTrip(t *testing.T) {
+	orig := BytesFrom([]byte("hello\x00world"))
+	orig.Format = BytesFormatBase64
+
+	data, err := orig.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Bytes
+	got.Format = BytesFormatBase64
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got.Bytes, orig.Bytes) || got.Valid != orig.Valid {
+		t.Errorf("base64 round trip: got %+v, want %+v", got, orig)
+	}
+}
+
+func TestBytesMarshalJSONRawRoundTrip(t *testing.T) {
+	orig := Bytes{NullBytes: NullBytes{Bytes: []byte(`{"a":1}`), Valid: true}, Format: BytesFormatRaw}
+
+	data, err := orig.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, orig.Bytes) {
+		t.Errorf("raw marshal should pass the payload through verbatim, got %s", data)
+	}
+
+	var got Bytes
+	got.Format = BytesFormatRaw
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes, orig.Bytes) || !got.Valid {
+		t.Errorf("raw round trip: got %+v, want %+v", got, orig)
+	}
+}
+
+func TestBytesMarshalJSONHexRoundTrip(t *testing.T) {
+	orig := BytesFrom([]byte{0xde, 0xad, 0xbe, 0xef})
+	orig.Format = BytesFormatHex
+
+	data, err := orig.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"deadbeef"` {
+		t.Errorf("hex marshal: got %s, want \"deadbeef\"", data)
+	}
+
+	var got Bytes
+	got.Format = BytesFormatHex
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes, orig.Bytes) || !got.Valid {
+		t.Errorf("hex round trip: got %+v, want %+v", got, orig)
+	}
+}
+
+func TestBytesMarshalJSONNull(t *testing.T) {
+	var b Bytes
+	data, err := b.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("invalid Bytes should marshal to null, got %s", data)
+	}
+
+	var got Bytes
+	got.SetValid([]byte("leftover"))
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if got.Valid || got.Bytes != nil {
+		t.Errorf("unmarshaling null should reset to invalid, got %+v", got)
+	}
+}
+
+func TestBytesFuzzBase64RoundTrip(t *testing.T) {
+	f := func(payload []byte) bool {
+		orig := BytesFrom(payload)
+		if len(payload) == 0 {
+			return true
+		}
+
+		data, err := orig.MarshalJSON()
+		if err != nil {
+			return false
+		}
+
+		var got Bytes
+		if err := got.UnmarshalJSON(data); err != nil {
+			return false
+		}
+
+		return bytes.Equal(got.Bytes, orig.Bytes) && got.Valid == orig.Valid
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBytesFuzzHexRoundTrip(t *testing.T) {
+	f := func(payload []byte) bool {
+		if len(payload) == 0 {
+			return true
+		}
+		orig := Bytes{NullBytes: NullBytes{Bytes: payload, Valid: true}, Format: BytesFormatHex}
+
+		data, err := orig.MarshalJSON()
+		if err != nil {
+			return false
+		}
+
+		got := Bytes{Format: BytesFormatHex}
+		if err := got.UnmarshalJSON(data); err != nil {
+			return false
+		}
+
+		return bytes.Equal(got.Bytes, orig.Bytes) && got.Valid
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
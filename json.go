@@ -0,0 +1,171 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/nullbio/null.v4/convert"
+)
+
+// NullJSON is a nullable JSON value.
+type NullJSON struct {
+	JSON  []byte
+	Valid bool
+}
+
+// JSON is a nullable []byte that holds a validated JSON payload.
+// JSON marshals to null if null.
+// Considered null to SQL only if not Valid.
+type JSON struct {
+	NullJSON
+}
+
+// NewJSON creates a new JSON
+func NewJSON(b []byte, valid bool) JSON {
+	return JSON{
+		NullJSON: NullJSON{
+			JSON:  b,
+			Valid: valid,
+		},
+	}
+}
+
+// JSONFrom creates a new JSON that will be null if len zero.
+func JSONFrom(b []byte) JSON {
+	return NewJSON(b, len(b) != 0)
+}
+
+// JSONFromPtr creates a new JSON that be null if len zero.
+func JSONFromPtr(b *[]byte) JSON {
+	if b == nil || len(*b) == 0 {
+		return NewJSON(nil, false)
+	}
+	n := NewJSON(*b, true)
+	return n
+}
+
+// Marshal marshals src and stores the result, making this JSON valid.
+func (j *JSON) Marshal(src interface{}) error {
+	res, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	j.JSON = res
+	j.Valid = true
+
+	return nil
+}
+
+// Unmarshal unmarshals the stored JSON into dest. It is a no-op if
+// this JSON is not valid.
+func (j JSON) Unmarshal(dest interface{}) error {
+	if !j.Valid {
+		return nil
+	}
+	return json.Unmarshal(j.JSON, dest)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It treats the token "null" as a valid non-NULL JSON value, distinct
+// from Valid=false, and rejects anything that is not valid JSON.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if data == nil || len(data) == 0 {
+		j.JSON = nil
+		j.Valid = false
+		return nil
+	}
+
+	if !json.Valid(data) {
+		return fmt.Errorf("null: JSON.UnmarshalJSON: invalid JSON: %s", data)
+	}
+
+	j.JSON = append(j.JSON[0:0], data...)
+	j.Valid = true
+
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null JSON if the input is blank.
+// It will return an error if the input is not valid JSON.
+func (j *JSON) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		j.Valid = false
+		return nil
+	}
+
+	if !json.Valid(text) {
+		return fmt.Errorf("null: JSON.UnmarshalText: invalid JSON: %s", text)
+	}
+
+	j.JSON = append(j.JSON[0:0], text...)
+	j.Valid = true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if the JSON is invalid.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if !j.Valid {
+		return nullLiteral, nil
+	}
+	return j.JSON, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode nil if the JSON is invalid.
+func (j JSON) MarshalText() ([]byte, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	return j.JSON, nil
+}
+
+// SetValid changes this JSON's value and also sets it to be non-null.
+func (j *JSON) SetValid(n []byte) {
+	j.JSON = n
+	j.Valid = true
+}
+
+// Ptr returns a pointer to this JSON's value, or a nil pointer if this JSON is null.
+func (j JSON) Ptr() *[]byte {
+	if !j.Valid {
+		return nil
+	}
+	return &j.JSON
+}
+
+// IsZero returns true for a null JSON, for future omitempty support (Go 1.4?)
+func (j JSON) IsZero() bool {
+	return !j.Valid
+}
+
+// Scan implements the Scanner interface, for Postgres json/jsonb columns.
+// It returns an error if the scanned value is not valid JSON.
+func (n *NullJSON) Scan(value interface{}) error {
+	if value == nil {
+		n.JSON, n.Valid = []byte{}, false
+		return nil
+	}
+	if err := convert.ConvertAssign(&n.JSON, value); err != nil {
+		return err
+	}
+	if !json.Valid(n.JSON) {
+		n.Valid = false
+		return fmt.Errorf("null: scanned value %q is not valid JSON", n.JSON)
+	}
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullJSON) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.JSON, nil
+}
@@ -0,0 +1,115 @@
+package null
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestJSONConstructors(t *testing.T) {
+	if j := NewJSON([]byte(`{"a":1}`), true); !j.Valid {
+		t.Errorf("NewJSON(..., true) should be valid")
+	}
+	if j := JSONFrom([]byte(`1`)); !j.Valid {
+		t.Errorf("JSONFrom of non-empty bytes should be valid")
+	}
+	if j := JSONFrom(nil); j.Valid {
+		t.Errorf("JSONFrom(nil) should be invalid")
+	}
+	if j := JSONFromPtr(nil); j.Valid {
+		t.Errorf("JSONFromPtr(nil) should be invalid")
+	}
+	b := []byte(`true`)
+	if j := JSONFromPtr(&b); !j.Valid || !bytes.Equal(j.JSON, b) {
+		t.Errorf("JSONFromPtr should carry the pointed-to bytes")
+	}
+}
+
+func TestJSONMarshalUnmarshalHelpers(t *testing.T) {
+	var j JSON
+	if err := j.Marshal(map[string]int{"a": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if !j.Valid {
+		t.Errorf("Marshal should make the JSON valid")
+	}
+
+	var dest map[string]int
+	if err := j.Unmarshal(&dest); err != nil {
+		t.Fatal(err)
+	}
+	if dest["a"] != 1 {
+		t.Errorf("Unmarshal: got %+v", dest)
+	}
+
+	var invalid JSON
+	if err := invalid.Unmarshal(&dest); err != nil {
+		t.Errorf("Unmarshal on an invalid JSON should be a no-op, got err %v", err)
+	}
+}
+
+func TestJSONUnmarshalJSONRejectsInvalid(t *testing.T) {
+	var j JSON
+	if err := j.UnmarshalJSON([]byte(`{not valid`)); err == nil {
+		t.Errorf("malformed JSON should be rejected")
+	}
+}
+
+func TestJSONMarshalJSONRoundTrip(t *testing.T) {
+	orig := JSONFrom([]byte(`{"a":1,"b":[1,2,3]}`))
+
+	data, err := orig.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, orig.JSON) {
+		t.Errorf("JSON should marshal verbatim, got %s", data)
+	}
+
+	var got JSON
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.JSON, orig.JSON) || got.Valid != orig.Valid {
+		t.Errorf("round trip: got %+v, want %+v", got, orig)
+	}
+}
+
+func TestJSONScanRejectsInvalidJSON(t *testing.T) {
+	var n NullJSON
+	if err := n.Scan([]byte(`{not valid`)); err == nil {
+		t.Errorf("Scan should reject a column value that isn't valid JSON")
+	}
+	if n.Valid {
+		t.Errorf("a rejected Scan should leave Valid false, got %+v", n)
+	}
+}
+
+func TestJSONScanValueRoundTrip(t *testing.T) {
+	var n NullJSON
+	if err := n.Scan([]byte(`{"a":1}`)); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid {
+		t.Errorf("Scan of valid JSON should be valid")
+	}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(v.([]byte), n.JSON) {
+		t.Errorf("Value should echo the scanned JSON, got %v", v)
+	}
+
+	var null NullJSON
+	if err := null.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	v, err = null.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value of a null JSON should be nil, got %v", v)
+	}
+}
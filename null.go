@@ -0,0 +1,9 @@
+package null
+
+// nullLiteral is the shared JSON "null" token used by the MarshalJSON and
+// UnmarshalJSON methods in this package that emit or recognise it
+// (JSON.UnmarshalJSON keeps null as a value and does not check for it). It can't be exported as
+// NullBytes -- that identifier is already taken by the NullBytes struct
+// -- so it stays package-private; the point is a single definition, not
+// a public API.
+var nullLiteral = []byte("null")
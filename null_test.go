@@ -0,0 +1,60 @@
+package null
+
+import "testing"
+
+func TestBytesUnmarshalJSONNullLiteral(t *testing.T) {
+	var b Bytes
+	b.SetValid([]byte("leftover"))
+	if err := b.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+	if b.Valid || b.Bytes != nil {
+		t.Errorf("JSON null should unmarshal to invalid Bytes, got %+v", b)
+	}
+}
+
+func TestBytesUnmarshalTextNullIsLiteralText(t *testing.T) {
+	var b Bytes
+	if err := b.UnmarshalText([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+	if !b.Valid || string(b.Bytes) != "null" {
+		t.Errorf("UnmarshalText must treat \"null\" as literal text, not a null token, got %+v", b)
+	}
+
+	if err := b.UnmarshalText(nil); err != nil {
+		t.Fatal(err)
+	}
+	if b.Valid {
+		t.Errorf("UnmarshalText with empty input should be invalid, got %+v", b)
+	}
+}
+
+func TestJSONUnmarshalJSONNullTokenIsValidValue(t *testing.T) {
+	var j JSON
+	if err := j.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+	if !j.Valid || string(j.JSON) != "null" {
+		t.Errorf("JSON null token should be a valid non-NULL JSON value, got %+v", j)
+	}
+
+	var blank JSON
+	if err := blank.UnmarshalJSON(nil); err != nil {
+		t.Fatal(err)
+	}
+	if blank.Valid {
+		t.Errorf("empty input should unmarshal to an invalid JSON, got %+v", blank)
+	}
+}
+
+func TestByteUnmarshalJSONNullLiteral(t *testing.T) {
+	var b Byte
+	b.SetValid('x')
+	if err := b.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+	if b.Valid {
+		t.Errorf("JSON null should unmarshal to invalid Byte, got %+v", b)
+	}
+}